@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"strings"
+)
+
+// boolFlag matches flag.boolFlag's shape: flag.Value implementations for
+// bool-typed flags (e.g. those registered via FlagSet.Bool) implement it so
+// "-name" alone, without a following value, is recognized as valid.
+type boolFlag interface {
+	IsBoolFlag() bool
+}
+
+// parseArgs parses args against fs, allowing flags to appear before,
+// after, or interspersed with positional arguments (flag.FlagSet.Parse
+// alone stops at the first non-flag argument). It separates recognized
+// flag tokens (and their values) from positional arguments before handing
+// the flags to fs.Parse, then returns the positional arguments in their
+// original relative order.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	known := map[string]*flag.Flag{}
+	fs.VisitAll(func(f *flag.Flag) { known[f.Name] = f })
+
+	var flagArgs, positional []string
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "-" || len(a) < 2 || a[0] != '-' {
+			positional = append(positional, a)
+			continue
+		}
+		flagArgs = append(flagArgs, a)
+		name := strings.TrimLeft(a, "-")
+		if strings.ContainsRune(name, '=') {
+			continue // value is attached as -name=value
+		}
+		f := known[name]
+		if f == nil || i+1 >= len(args) {
+			continue
+		}
+		if bf, ok := f.Value.(boolFlag); ok && bf.IsBoolFlag() {
+			continue // bool flags don't consume the next arg as a value
+		}
+		i++
+		flagArgs = append(flagArgs, args[i])
+	}
+	if err := fs.Parse(flagArgs); err != nil {
+		return nil, err
+	}
+	return append(positional, fs.Args()...), nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+)
+
+func TestParseArgsFlagOrdering(t *testing.T) {
+	cases := [][]string{
+		{"-o", "out.bin", "spec.yaml"},
+		{"spec.yaml", "-o", "out.bin"},
+	}
+	for _, args := range cases {
+		fs := flag.NewFlagSet("create", flag.ContinueOnError)
+		out := fs.String("o", "", "")
+		positional, err := parseArgs(fs, args)
+		if err != nil {
+			t.Fatalf("parseArgs(%v): %v", args, err)
+		}
+		if *out != "out.bin" {
+			t.Errorf("parseArgs(%v): -o = %q, want %q", args, *out, "out.bin")
+		}
+		if want := []string{"spec.yaml"}; !reflect.DeepEqual(positional, want) {
+			t.Errorf("parseArgs(%v) positional = %v, want %v", args, positional, want)
+		}
+	}
+}
+
+func TestParseArgsBoolFlagDoesNotConsumeNextArg(t *testing.T) {
+	fs := flag.NewFlagSet("dump", flag.ContinueOnError)
+	verbose := fs.Bool("v", false, "")
+	positional, err := parseArgs(fs, []string{"-v", "file.bin"})
+	if err != nil {
+		t.Fatalf("parseArgs: %v", err)
+	}
+	if !*verbose {
+		t.Error("-v = false, want true")
+	}
+	if want := []string{"file.bin"}; !reflect.DeepEqual(positional, want) {
+		t.Errorf("positional = %v, want %v", positional, want)
+	}
+}
+
+func TestParseArgsEqualsForm(t *testing.T) {
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	out := fs.String("o", "", "")
+	positional, err := parseArgs(fs, []string{"spec.yaml", "-o=out.bin"})
+	if err != nil {
+		t.Fatalf("parseArgs: %v", err)
+	}
+	if *out != "out.bin" {
+		t.Errorf("-o = %q, want %q", *out, "out.bin")
+	}
+	if want := []string{"spec.yaml"}; !reflect.DeepEqual(positional, want) {
+		t.Errorf("positional = %v, want %v", positional, want)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hclihn/FRU-tool/fru"
+)
+
+// runCreate builds a FRU binary from a human-authored YAML or JSON spec.
+func runCreate(args []string) error {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	out := fs.String("o", "", "output FRU binary path (required)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("create: usage: create -o <path.bin> <spec.yaml|spec.json>")
+	}
+	if *out == "" {
+		return fmt.Errorf("create: -o output path is required")
+	}
+	f, err := readSpec(positional[0])
+	if err != nil {
+		return err
+	}
+	data, err := fru.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("building FRU image from %s: %w", positional[0], err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", *out, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hclihn/FRU-tool/fru"
+)
+
+// runDiff decodes two FRU binaries and prints the lines of their YAML
+// representation that differ.
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 2 {
+		return fmt.Errorf("diff: usage: diff <a.bin> <b.bin>")
+	}
+	aPath, bPath := fs.Arg(0), fs.Arg(1)
+	a, err := loadAsYAML(aPath)
+	if err != nil {
+		return err
+	}
+	b, err := loadAsYAML(bPath)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(a, b) {
+		fmt.Println("no differences")
+		return nil
+	}
+	printLineDiff(aPath, bPath, string(a), string(b))
+	return nil
+}
+
+func loadAsYAML(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	f, err := fru.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	var buf bytes.Buffer
+	if err := writeSpec(&buf, f, "yaml"); err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", path, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// printLineDiff prints a positional line-oriented diff: at each line index
+// where a and b differ, the line from a is prefixed "-" (aLabel) and the
+// line from b "+" (bLabel). Lines are compared by position only, so an
+// inserted or removed line makes every later line show as changed.
+func printLineDiff(aLabel, bLabel, a, b string) {
+	aLines := strings.Split(strings.TrimRight(a, "\n"), "\n")
+	bLines := strings.Split(strings.TrimRight(b, "\n"), "\n")
+	fmt.Printf("--- %s\n+++ %s\n", aLabel, bLabel)
+	n := len(aLines)
+	if len(bLines) > n {
+		n = len(bLines)
+	}
+	for i := 0; i < n; i++ {
+		var al, bl string
+		haveA, haveB := i < len(aLines), i < len(bLines)
+		if haveA {
+			al = aLines[i]
+		}
+		if haveB {
+			bl = bLines[i]
+		}
+		if haveA && haveB && al == bl {
+			continue
+		}
+		if haveA {
+			fmt.Printf("-%s\n", al)
+		}
+		if haveB {
+			fmt.Printf("+%s\n", bl)
+		}
+	}
+}
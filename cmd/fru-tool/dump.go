@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hclihn/FRU-tool/fru"
+)
+
+// runDump decodes a FRU binary and prints it as YAML or JSON.
+func runDump(args []string) error {
+	fs := flag.NewFlagSet("dump", flag.ExitOnError)
+	format := fs.String("o", "yaml", "output format: yaml or json")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return fmt.Errorf("dump: usage: dump [-o yaml|json] <path.bin>")
+	}
+	data, err := os.ReadFile(positional[0])
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", positional[0], err)
+	}
+	f, err := fru.Unmarshal(data)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", positional[0], err)
+	}
+	return writeSpec(os.Stdout, f, *format)
+}
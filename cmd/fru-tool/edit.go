@@ -0,0 +1,122 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hclihn/FRU-tool/fru"
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// runEdit applies one or more "area.field=value" updates to a FRU binary
+// in place.
+func runEdit(args []string) error {
+	fs := flag.NewFlagSet("edit", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		return fmt.Errorf("edit: usage: edit <path.bin> <area.field>=<value> [...]")
+	}
+	path := fs.Arg(0)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	f, err := fru.Unmarshal(data)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for _, kv := range fs.Args()[1:] {
+		selector, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return fmt.Errorf("edit: invalid update %q: want <area.field>=<value>", kv)
+		}
+		if err := setField(f, selector, value); err != nil {
+			return fmt.Errorf("edit: %w", err)
+		}
+	}
+	out, err := fru.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("rebuilding %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// setField updates the field of f named by selector ("area.field") to
+// value as a text field; fru.Marshal later encodes it as BCD+, Packed 6-bit
+// ASCII, or (depending on the area's language code) 8-bit ASCII/Latin-1 or
+// UTF-16LE, whichever is most compact.
+func setField(f *fru.FRU, selector, value string) error {
+	area, field, ok := strings.Cut(selector, ".")
+	if !ok {
+		return fmt.Errorf("invalid selector %q: want <area>.<field>", selector)
+	}
+	dst, err := fieldTarget(f, area, field)
+	if err != nil {
+		return err
+	}
+	*dst = fruenc.TypeLengthField{Type: fruenc.FieldTypeText, Value: value}
+	return nil
+}
+
+// fieldTarget returns a pointer to the TypeLengthField named by area and
+// field, so it can be overwritten in place.
+func fieldTarget(f *fru.FRU, area, field string) (*fruenc.TypeLengthField, error) {
+	switch area {
+	case "chassis":
+		if f.ChassisInfo == nil {
+			return nil, fmt.Errorf("FRU has no chassis info area")
+		}
+		switch field {
+		case "part_number":
+			return &f.ChassisInfo.PartNumber, nil
+		case "serial_number":
+			return &f.ChassisInfo.SerialNumber, nil
+		}
+	case "board":
+		if f.BoardInfo == nil {
+			return nil, fmt.Errorf("FRU has no board info area")
+		}
+		switch field {
+		case "manufacturer":
+			return &f.BoardInfo.Manufacturer, nil
+		case "product_name":
+			return &f.BoardInfo.ProductName, nil
+		case "serial_number":
+			return &f.BoardInfo.SerialNumber, nil
+		case "part_number":
+			return &f.BoardInfo.PartNumber, nil
+		case "fru_file_id":
+			return &f.BoardInfo.FRUFileID, nil
+		}
+	case "product":
+		if f.ProductInfo == nil {
+			return nil, fmt.Errorf("FRU has no product info area")
+		}
+		switch field {
+		case "manufacturer":
+			return &f.ProductInfo.Manufacturer, nil
+		case "product_name":
+			return &f.ProductInfo.ProductName, nil
+		case "part_number":
+			return &f.ProductInfo.PartNumber, nil
+		case "version":
+			return &f.ProductInfo.Version, nil
+		case "serial_number":
+			return &f.ProductInfo.SerialNumber, nil
+		case "asset_tag":
+			return &f.ProductInfo.AssetTag, nil
+		case "fru_file_id":
+			return &f.ProductInfo.FRUFileID, nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown area %q: want chassis, board, or product", area)
+	}
+	return nil, fmt.Errorf("unknown %s field %q", area, field)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hclihn/FRU-tool/fru"
+)
+
+func TestSetFieldUpdatesTarget(t *testing.T) {
+	f := &fru.FRU{BoardInfo: &fru.BoardInfoArea{}}
+	if err := setField(f, "board.serial_number", "SN123"); err != nil {
+		t.Fatalf("setField: %v", err)
+	}
+	if got := f.BoardInfo.SerialNumber.Value; got != "SN123" {
+		t.Errorf("board serial number = %q, want %q", got, "SN123")
+	}
+}
+
+func TestSetFieldUnknownArea(t *testing.T) {
+	f := &fru.FRU{}
+	if err := setField(f, "bogus.field", "x"); err == nil {
+		t.Fatal("expected error for unknown area, got nil")
+	}
+}
+
+func TestSetFieldAreaAbsent(t *testing.T) {
+	f := &fru.FRU{}
+	if err := setField(f, "board.serial_number", "SN123"); err == nil {
+		t.Fatal("expected error for absent board info area, got nil")
+	}
+}
+
+func TestSetFieldUnknownField(t *testing.T) {
+	f := &fru.FRU{BoardInfo: &fru.BoardInfoArea{}}
+	if err := setField(f, "board.bogus_field", "x"); err == nil {
+		t.Fatal("expected error for unknown field, got nil")
+	}
+}
+
+func TestSetFieldBadSelector(t *testing.T) {
+	f := &fru.FRU{}
+	if err := setField(f, "noseparator", "x"); err == nil {
+		t.Fatal("expected error for selector without a dot, got nil")
+	}
+}
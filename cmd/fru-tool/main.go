@@ -0,0 +1,62 @@
+// Command fru-tool reads, writes, and edits IPMI Platform Management FRU
+// binaries, round-tripping them through human-editable YAML or JSON specs.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "dump":
+		err = runDump(os.Args[2:])
+	case "create":
+		err = runCreate(os.Args[2:])
+	case "verify":
+		err = runVerify(os.Args[2:])
+	case "edit":
+		err = runEdit(os.Args[2:])
+	case "diff":
+		err = runDiff(os.Args[2:])
+	case "selftest":
+		err = runSelftest(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "fru-tool: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "fru-tool:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprint(os.Stderr, `usage: fru-tool <command> [arguments]
+
+commands:
+  dump [-o yaml|json] <path.bin>
+        decode a FRU binary and print it as YAML or JSON
+  create -o <path.bin> <spec.yaml|spec.json>
+        build a FRU binary from a human-authored spec
+  verify <path.bin>
+        validate the header, area, and record checksums and report the
+        first failure
+  edit <path.bin> <area.field>=<value> [...]
+        update one or more fields in place, e.g. board.serial_number=SN123
+  diff <a.bin> <b.bin>
+        show differences between two decoded FRUs
+  selftest
+        run the codec smoke test
+`)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// runSelftest runs the original codec smoke test: it exercises the BCD+
+// and Packed 6-bit ASCII codecs and the zero checksum function and prints
+// their results for manual inspection.
+func runSelftest(args []string) error {
+	s := "123-456-7.890"
+	var x fruenc.BCDPlusBytes
+	fmt.Println(x.Encode([]byte(s)))
+	fmt.Println(hex.Dump(x))
+	sb, err := x.Decode(false)
+	fmt.Printf("%q err=%v\n", sb, err)
+
+	t := "IPMITOOL 12"
+	var p fruenc.Packed6BitAsciiBytes
+	fmt.Println(p.Encode([]byte(t)))
+	fmt.Println(hex.Dump(p))
+	sb, err = p.Decode(true)
+	fmt.Printf("%q err=%v\n", sb, err)
+
+	data := []byte{0xff, 0xff, 0x3, 0xff, 0x3, 0x3, 0x4, 0x5, 0xff, 0x7, 0x7, 0x8, 0x9, 0xff, 0xb}
+	fmt.Println(fruenc.CalculateZeroChecksum(data, 0, len(data)))
+	fmt.Println(256 - 55)
+	return nil
+}
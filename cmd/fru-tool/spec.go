@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hclihn/FRU-tool/fru"
+	"gopkg.in/yaml.v3"
+)
+
+// isJSON reports whether path should be treated as JSON rather than YAML,
+// based on its extension (YAML is the default).
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+// readSpec loads a FRU spec (as produced by "dump" or hand-authored) from
+// path, in YAML or JSON depending on its extension.
+func readSpec(path string) (*fru.FRU, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var f fru.FRU
+	if isJSON(path) {
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, fmt.Errorf("parsing %s as JSON: %w", path, err)
+		}
+	} else {
+		if err := yaml.Unmarshal(raw, &f); err != nil {
+			return nil, fmt.Errorf("parsing %s as YAML: %w", path, err)
+		}
+	}
+	return &f, nil
+}
+
+// writeSpec writes f to w as YAML or JSON (format must be "yaml" or
+// "json"; "" defaults to "yaml").
+func writeSpec(w io.Writer, f *fru.FRU, format string) error {
+	switch format {
+	case "", "yaml":
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+		return enc.Encode(f)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(f)
+	default:
+		return fmt.Errorf("unknown output format %q: want yaml or json", format)
+	}
+}
@@ -0,0 +1,68 @@
+package fru
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// areaSpan locates an area within the image given its offset field (in
+// areaLenUnit units) and the whole image. It reads the area's own
+// length byte (always the second byte of an area) to determine its extent,
+// then validates the area's trailing zero checksum.
+func areaSpan(image []byte, offset byte) ([]byte, error) {
+	start := offsetBytes(offset)
+	if start+2 > len(image) {
+		return nil, fmt.Errorf("area at offset %d (byte %d) is truncated: image is only %d bytes", offset, start, len(image))
+	}
+	length := int(image[start+1]) * areaLenUnit
+	end := start + length
+	if length == 0 || end > len(image) {
+		return nil, fmt.Errorf("area at offset %d declares length %d bytes, which exceeds the %d-byte image", offset, length, len(image))
+	}
+	area := image[start:end]
+	sum, err := fruenc.CalculateZeroChecksum(area, 0, length-1)
+	if err != nil {
+		return nil, fmt.Errorf("computing area checksum: %w", err)
+	}
+	if got := area[length-1]; sum != got {
+		return nil, fmt.Errorf("area at offset %d checksum mismatch: got 0x%02x, want 0x%02x", offset, got, sum)
+	}
+	return area, nil
+}
+
+// readCustomFields reads TypeLengthField values from r until the
+// end-of-fields sentinel is seen, returning the fields read (not including
+// the sentinel itself).
+func readCustomFields(r *bytes.Reader, lang byte) ([]fruenc.TypeLengthField, error) {
+	var fields []fruenc.TypeLengthField
+	for {
+		f, err := fruenc.ParseTypeLength(r, lang)
+		if err != nil {
+			return nil, fmt.Errorf("reading custom field #%d: %w", len(fields), err)
+		}
+		if f.EndOfFields {
+			return fields, nil
+		}
+		fields = append(fields, f)
+	}
+}
+
+// finishArea appends the end-of-fields sentinel to buf (which must start
+// with the format version byte and a placeholder length byte), pads it to
+// a multiple of areaLenUnit bytes, fills in the length byte, and appends
+// the computed zero checksum byte.
+func finishArea(buf []byte) ([]byte, error) {
+	buf = append(buf, 0xc1) // end-of-fields sentinel
+	buf = append(buf, make([]byte, padLen(len(buf)))...)
+	total := len(buf) + 1 // + checksum byte
+	buf[1] = byte(total / areaLenUnit)
+	buf = append(buf, 0) // checksum placeholder
+	sum, err := fruenc.CalculateZeroChecksum(buf, 0, len(buf)-1)
+	if err != nil {
+		return nil, fmt.Errorf("computing area checksum: %w", err)
+	}
+	buf[len(buf)-1] = sum
+	return buf, nil
+}
@@ -0,0 +1,88 @@
+package fru
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// BoardInfoArea is the board info area.
+type BoardInfoArea struct {
+	LanguageCode byte                     `yaml:"language_code" json:"language_code"`
+	MfgDateTime  time.Time                `yaml:"mfg_date_time" json:"mfg_date_time"`
+	Manufacturer fruenc.TypeLengthField   `yaml:"manufacturer" json:"manufacturer"`
+	ProductName  fruenc.TypeLengthField   `yaml:"product_name" json:"product_name"`
+	SerialNumber fruenc.TypeLengthField   `yaml:"serial_number" json:"serial_number"`
+	PartNumber   fruenc.TypeLengthField   `yaml:"part_number" json:"part_number"`
+	FRUFileID    fruenc.TypeLengthField   `yaml:"fru_file_id" json:"fru_file_id"`
+	CustomFields []fruenc.TypeLengthField `yaml:"custom_fields,omitempty" json:"custom_fields,omitempty"`
+}
+
+// parseBoardInfoArea decodes a board info area. area must be exactly the
+// area's bytes (format version through checksum, inclusive), with its
+// checksum already validated by the caller.
+func parseBoardInfoArea(area []byte) (BoardInfoArea, error) {
+	if len(area) < 6 {
+		return BoardInfoArea{}, fmt.Errorf("board info area too short (%d bytes)", len(area))
+	}
+	var a BoardInfoArea
+	a.LanguageCode = area[2]
+	a.MfgDateTime = decodeMfgDateTime([3]byte{area[3], area[4], area[5]})
+
+	r := bytes.NewReader(area[6 : len(area)-1])
+	var err error
+	if a.Manufacturer, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return BoardInfoArea{}, fmt.Errorf("reading board manufacturer: %w", err)
+	}
+	if a.ProductName, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return BoardInfoArea{}, fmt.Errorf("reading board product name: %w", err)
+	}
+	if a.SerialNumber, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return BoardInfoArea{}, fmt.Errorf("reading board serial number: %w", err)
+	}
+	if a.PartNumber, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return BoardInfoArea{}, fmt.Errorf("reading board part number: %w", err)
+	}
+	if a.FRUFileID, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return BoardInfoArea{}, fmt.Errorf("reading board FRU file ID: %w", err)
+	}
+	if a.CustomFields, err = readCustomFields(r, a.LanguageCode); err != nil {
+		return BoardInfoArea{}, fmt.Errorf("reading board custom fields: %w", err)
+	}
+	return a, nil
+}
+
+// marshal encodes the board info area, including its format version,
+// length, and checksum bytes.
+func (a BoardInfoArea) marshal() ([]byte, error) {
+	mfg, err := encodeMfgDateTime(a.MfgDateTime)
+	if err != nil {
+		return nil, fmt.Errorf("board mfg date/time: %w", err)
+	}
+	buf := []byte{formatVersion1, 0, a.LanguageCode, mfg[0], mfg[1], mfg[2]}
+	var b bytes.Buffer
+	fields := []struct {
+		name string
+		f    fruenc.TypeLengthField
+	}{
+		{"manufacturer", a.Manufacturer},
+		{"product name", a.ProductName},
+		{"serial number", a.SerialNumber},
+		{"part number", a.PartNumber},
+		{"FRU file ID", a.FRUFileID},
+	}
+	for _, fd := range fields {
+		if err := fd.f.Marshal(&b, a.LanguageCode); err != nil {
+			return nil, fmt.Errorf("writing board %s: %w", fd.name, err)
+		}
+	}
+	for i, f := range a.CustomFields {
+		if err := f.Marshal(&b, a.LanguageCode); err != nil {
+			return nil, fmt.Errorf("writing board custom field #%d: %w", i, err)
+		}
+	}
+	buf = append(buf, b.Bytes()...)
+	return finishArea(buf)
+}
@@ -0,0 +1,59 @@
+package fru
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// ChassisInfoArea is the chassis info area.
+type ChassisInfoArea struct {
+	ChassisType  byte                     `yaml:"chassis_type" json:"chassis_type"`
+	PartNumber   fruenc.TypeLengthField   `yaml:"part_number" json:"part_number"`
+	SerialNumber fruenc.TypeLengthField   `yaml:"serial_number" json:"serial_number"`
+	CustomFields []fruenc.TypeLengthField `yaml:"custom_fields,omitempty" json:"custom_fields,omitempty"`
+}
+
+// parseChassisInfoArea decodes a chassis info area. area must be exactly
+// the area's bytes (format version through checksum, inclusive), with its
+// checksum already validated by the caller.
+func parseChassisInfoArea(area []byte) (ChassisInfoArea, error) {
+	if len(area) < 3 {
+		return ChassisInfoArea{}, fmt.Errorf("chassis info area too short (%d bytes)", len(area))
+	}
+	r := bytes.NewReader(area[3 : len(area)-1])
+	var a ChassisInfoArea
+	a.ChassisType = area[2]
+	var err error
+	if a.PartNumber, err = fruenc.ParseTypeLength(r, 0); err != nil {
+		return ChassisInfoArea{}, fmt.Errorf("reading chassis part number: %w", err)
+	}
+	if a.SerialNumber, err = fruenc.ParseTypeLength(r, 0); err != nil {
+		return ChassisInfoArea{}, fmt.Errorf("reading chassis serial number: %w", err)
+	}
+	if a.CustomFields, err = readCustomFields(r, 0); err != nil {
+		return ChassisInfoArea{}, fmt.Errorf("reading chassis custom fields: %w", err)
+	}
+	return a, nil
+}
+
+// marshal encodes the chassis info area, including its format version,
+// length, and checksum bytes.
+func (a ChassisInfoArea) marshal() ([]byte, error) {
+	buf := []byte{formatVersion1, 0, a.ChassisType}
+	var b bytes.Buffer
+	if err := a.PartNumber.Marshal(&b, 0); err != nil {
+		return nil, fmt.Errorf("writing chassis part number: %w", err)
+	}
+	if err := a.SerialNumber.Marshal(&b, 0); err != nil {
+		return nil, fmt.Errorf("writing chassis serial number: %w", err)
+	}
+	for i, f := range a.CustomFields {
+		if err := f.Marshal(&b, 0); err != nil {
+			return nil, fmt.Errorf("writing chassis custom field #%d: %w", i, err)
+		}
+	}
+	buf = append(buf, b.Bytes()...)
+	return finishArea(buf)
+}
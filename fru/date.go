@@ -0,0 +1,36 @@
+package fru
+
+import (
+	"fmt"
+	"time"
+)
+
+// fruEpoch is the zero point for board manufacturing date/time fields:
+// minutes are counted from 1996-01-01 00:00:00 UTC.
+var fruEpoch = time.Date(1996, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// maxMfgDateTimeMinutes is the largest minutes-since-fruEpoch value that
+// fits in the 3-byte field (1<<24 - 1).
+const maxMfgDateTimeMinutes = 1<<24 - 1
+
+// decodeMfgDateTime decodes a 3-byte little-endian minutes-since-fruEpoch
+// timestamp, as used by the board info area, into a time.Time.
+func decodeMfgDateTime(b [3]byte) time.Time {
+	minutes := int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+	return fruEpoch.Add(time.Duration(minutes) * time.Minute)
+}
+
+// encodeMfgDateTime encodes t as a 3-byte little-endian minutes-since-
+// fruEpoch timestamp. t is truncated to minute resolution. It returns an
+// error if t is before fruEpoch or too far after it to fit in the 3-byte
+// field (i.e. it does not round-trip through decodeMfgDateTime).
+func encodeMfgDateTime(t time.Time) ([3]byte, error) {
+	if t.Before(fruEpoch) {
+		return [3]byte{}, fmt.Errorf("mfg date/time %s is before the FRU epoch (%s)", t, fruEpoch)
+	}
+	minutes := int64(t.Sub(fruEpoch) / time.Minute)
+	if minutes > maxMfgDateTimeMinutes {
+		return [3]byte{}, fmt.Errorf("mfg date/time %s is too far after the FRU epoch (%s) to fit in the 3-byte field", t, fruEpoch)
+	}
+	return [3]byte{byte(minutes), byte(minutes >> 8), byte(minutes >> 16)}, nil
+}
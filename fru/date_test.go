@@ -0,0 +1,48 @@
+package fru
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEncodeMfgDateTimeRoundTrip(t *testing.T) {
+	want := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	b, err := encodeMfgDateTime(want)
+	if err != nil {
+		t.Fatalf("encodeMfgDateTime: %v", err)
+	}
+	got := decodeMfgDateTime(b)
+	if !got.Equal(want) {
+		t.Errorf("round trip %v -> %v", want, got)
+	}
+}
+
+func TestEncodeMfgDateTimeRejectsBeforeEpoch(t *testing.T) {
+	cases := []time.Time{
+		{}, // zero value, e.g. an omitted mfg_date_time in a spec
+		fruEpoch.Add(-time.Minute),
+		time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, tc := range cases {
+		if _, err := encodeMfgDateTime(tc); err == nil {
+			t.Errorf("encodeMfgDateTime(%v): expected error, got nil", tc)
+		}
+	}
+}
+
+func TestEncodeMfgDateTimeRejectsTooFarAfterEpoch(t *testing.T) {
+	tooFar := fruEpoch.Add((maxMfgDateTimeMinutes + 1) * time.Minute)
+	if _, err := encodeMfgDateTime(tooFar); err == nil {
+		t.Errorf("encodeMfgDateTime(%v): expected error, got nil", tooFar)
+	}
+
+	future := time.Date(2030, time.June, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := encodeMfgDateTime(future); err == nil {
+		t.Errorf("encodeMfgDateTime(%v): expected error, got nil", future)
+	}
+
+	inRange := fruEpoch.Add(maxMfgDateTimeMinutes * time.Minute)
+	if _, err := encodeMfgDateTime(inRange); err != nil {
+		t.Errorf("encodeMfgDateTime(%v): unexpected error: %v", inRange, err)
+	}
+}
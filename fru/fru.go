@@ -0,0 +1,189 @@
+package fru
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// FRU is a fully decoded IPMI Platform Management FRU Information Storage
+// image. Each area pointer is nil if the corresponding common header
+// offset is 0 (area absent).
+type FRU struct {
+	// Header is informational on Marshal: it is always recomputed from the
+	// areas actually present, so a hand-authored spec can omit it.
+	Header       CommonHeader     `yaml:"header,omitempty" json:"header,omitempty"`
+	InternalUse  *InternalUseArea `yaml:"internal_use,omitempty" json:"internal_use,omitempty"`
+	ChassisInfo  *ChassisInfoArea `yaml:"chassis_info,omitempty" json:"chassis_info,omitempty"`
+	BoardInfo    *BoardInfoArea   `yaml:"board_info,omitempty" json:"board_info,omitempty"`
+	ProductInfo  *ProductInfoArea `yaml:"product_info,omitempty" json:"product_info,omitempty"`
+	MultiRecords []MultiRecord    `yaml:"multi_records,omitempty" json:"multi_records,omitempty"`
+}
+
+// Unmarshal decodes a FRU image, validating the common header checksum and
+// each present area's zero checksum.
+func Unmarshal(data []byte) (*FRU, error) {
+	h, err := parseCommonHeader(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing common header at byte offset 0: %w", err)
+	}
+	f := &FRU{Header: h}
+
+	if h.InternalUseOffset != 0 {
+		start := offsetBytes(h.InternalUseOffset)
+		end := nextAreaBoundary(h, start, len(data))
+		if start >= len(data) || end > len(data) {
+			return nil, fmt.Errorf("internal use area at byte offset %d is truncated", start)
+		}
+		a := parseInternalUseArea(data[start:end])
+		f.InternalUse = &a
+	}
+	if h.ChassisInfoOffset != 0 {
+		start := offsetBytes(h.ChassisInfoOffset)
+		area, err := areaSpan(data, h.ChassisInfoOffset)
+		if err != nil {
+			return nil, fmt.Errorf("chassis info area at byte offset %d: %w", start, err)
+		}
+		a, err := parseChassisInfoArea(area)
+		if err != nil {
+			return nil, fmt.Errorf("chassis info area at byte offset %d: %w", start, err)
+		}
+		f.ChassisInfo = &a
+	}
+	if h.BoardInfoOffset != 0 {
+		start := offsetBytes(h.BoardInfoOffset)
+		area, err := areaSpan(data, h.BoardInfoOffset)
+		if err != nil {
+			return nil, fmt.Errorf("board info area at byte offset %d: %w", start, err)
+		}
+		a, err := parseBoardInfoArea(area)
+		if err != nil {
+			return nil, fmt.Errorf("board info area at byte offset %d: %w", start, err)
+		}
+		f.BoardInfo = &a
+	}
+	if h.ProductInfoOffset != 0 {
+		start := offsetBytes(h.ProductInfoOffset)
+		area, err := areaSpan(data, h.ProductInfoOffset)
+		if err != nil {
+			return nil, fmt.Errorf("product info area at byte offset %d: %w", start, err)
+		}
+		a, err := parseProductInfoArea(area)
+		if err != nil {
+			return nil, fmt.Errorf("product info area at byte offset %d: %w", start, err)
+		}
+		f.ProductInfo = &a
+	}
+	if h.MultiRecordOffset != 0 {
+		start := offsetBytes(h.MultiRecordOffset)
+		recs, err := parseMultiRecordArea(data, start)
+		if err != nil {
+			return nil, fmt.Errorf("multi-record area at byte offset %d: %w", start, err)
+		}
+		f.MultiRecords = recs
+	}
+	return f, nil
+}
+
+// nextAreaBoundary returns the byte offset of the area immediately
+// following the one starting at start, i.e. the smallest area offset in h
+// that is greater than start, or imageLen if none is.
+func nextAreaBoundary(h CommonHeader, start, imageLen int) int {
+	best := imageLen
+	for _, o := range []byte{h.ChassisInfoOffset, h.BoardInfoOffset, h.ProductInfoOffset, h.MultiRecordOffset} {
+		if o == 0 {
+			continue
+		}
+		if b := offsetBytes(o); b > start && b < best {
+			best = b
+		}
+	}
+	return best
+}
+
+// Marshal re-packs f into a FRU image: each present area is encoded, padded
+// to a multiple of areaLenUnit bytes, and its offset recorded in the
+// common header, with header and per-area checksums recomputed.
+func Marshal(f *FRU) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(make([]byte, headerLen)) // filled in once offsets are known
+
+	var h CommonHeader
+	if f.InternalUse != nil {
+		off, err := areaOffset(buf.Len())
+		if err != nil {
+			return nil, fmt.Errorf("internal use area: %w", err)
+		}
+		h.InternalUseOffset = off
+		buf.Write(f.InternalUse.marshal())
+	}
+	if f.ChassisInfo != nil {
+		off, err := areaOffset(buf.Len())
+		if err != nil {
+			return nil, fmt.Errorf("chassis info area: %w", err)
+		}
+		b, err := f.ChassisInfo.marshal()
+		if err != nil {
+			return nil, fmt.Errorf("chassis info area: %w", err)
+		}
+		h.ChassisInfoOffset = off
+		buf.Write(b)
+	}
+	if f.BoardInfo != nil {
+		off, err := areaOffset(buf.Len())
+		if err != nil {
+			return nil, fmt.Errorf("board info area: %w", err)
+		}
+		b, err := f.BoardInfo.marshal()
+		if err != nil {
+			return nil, fmt.Errorf("board info area: %w", err)
+		}
+		h.BoardInfoOffset = off
+		buf.Write(b)
+	}
+	if f.ProductInfo != nil {
+		off, err := areaOffset(buf.Len())
+		if err != nil {
+			return nil, fmt.Errorf("product info area: %w", err)
+		}
+		b, err := f.ProductInfo.marshal()
+		if err != nil {
+			return nil, fmt.Errorf("product info area: %w", err)
+		}
+		h.ProductInfoOffset = off
+		buf.Write(b)
+	}
+	if len(f.MultiRecords) > 0 {
+		off, err := areaOffset(buf.Len())
+		if err != nil {
+			return nil, fmt.Errorf("multi-record area: %w", err)
+		}
+		b, err := marshalMultiRecords(f.MultiRecords)
+		if err != nil {
+			return nil, fmt.Errorf("multi-record area: %w", err)
+		}
+		h.MultiRecordOffset = off
+		buf.Write(b)
+		if rem := buf.Len() % areaLenUnit; rem != 0 {
+			buf.Write(make([]byte, areaLenUnit-rem))
+		}
+	}
+
+	hdrBytes, err := h.marshal()
+	if err != nil {
+		return nil, fmt.Errorf("common header: %w", err)
+	}
+	out := buf.Bytes()
+	copy(out[:headerLen], hdrBytes)
+	return out, nil
+}
+
+// areaOffset converts a byte offset (which must already be a multiple of
+// areaLenUnit, guaranteed since every area is padded to one) into the
+// common header's area-offset unit, erroring if it overflows a byte.
+func areaOffset(byteOffset int) (byte, error) {
+	off := byteOffset / areaLenUnit
+	if off > 0xff {
+		return 0, fmt.Errorf("image too large: area offset %d exceeds the 1-byte offset field", off)
+	}
+	return byte(off), nil
+}
@@ -0,0 +1,101 @@
+package fru
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+func textField(s string) fruenc.TypeLengthField {
+	return fruenc.TypeLengthField{Type: fruenc.FieldTypeText, Value: s}
+}
+
+func sampleFRU() *FRU {
+	return &FRU{
+		ChassisInfo: &ChassisInfoArea{
+			ChassisType:  0x17,
+			PartNumber:   textField("CHASSIS-PN"),
+			SerialNumber: textField("CHASSIS-SN"),
+		},
+		BoardInfo: &BoardInfoArea{
+			MfgDateTime:  time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+			Manufacturer: textField("Acme Corp"),
+			ProductName:  textField("Widget"),
+			SerialNumber: textField("BOARD-SN"),
+			PartNumber:   textField("BOARD-PN"),
+			FRUFileID:    textField("FILE-1"),
+		},
+		ProductInfo: &ProductInfoArea{
+			Manufacturer: textField("Acme Corp"),
+			ProductName:  textField("Widget Pro"),
+			PartNumber:   textField("PROD-PN"),
+			Version:      textField("1.0"),
+			SerialNumber: textField("PROD-SN"),
+			AssetTag:     textField("ASSET-1"),
+			FRUFileID:    textField("FILE-2"),
+		},
+	}
+}
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	want := sampleFRU()
+	data, err := Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if len(data)%areaLenUnit != 0 {
+		t.Errorf("image length %d is not a multiple of %d", len(data), areaLenUnit)
+	}
+	got, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.ChassisInfo.PartNumber.Value != want.ChassisInfo.PartNumber.Value {
+		t.Errorf("chassis part number = %q, want %q", got.ChassisInfo.PartNumber.Value, want.ChassisInfo.PartNumber.Value)
+	}
+	if got.BoardInfo.Manufacturer.Value != want.BoardInfo.Manufacturer.Value {
+		t.Errorf("board manufacturer = %q, want %q", got.BoardInfo.Manufacturer.Value, want.BoardInfo.Manufacturer.Value)
+	}
+	if !got.BoardInfo.MfgDateTime.Equal(want.BoardInfo.MfgDateTime) {
+		t.Errorf("board mfg date/time = %v, want %v", got.BoardInfo.MfgDateTime, want.BoardInfo.MfgDateTime)
+	}
+	if got.ProductInfo.AssetTag.Value != want.ProductInfo.AssetTag.Value {
+		t.Errorf("product asset tag = %q, want %q", got.ProductInfo.AssetTag.Value, want.ProductInfo.AssetTag.Value)
+	}
+}
+
+func TestUnmarshalRejectsUnsupportedFormatVersion(t *testing.T) {
+	data, err := Marshal(sampleFRU())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	data[0] = (data[0] &^ 0x0f) | 0x02 // bump format version, checksum now wrong too
+	sum, err := fruenc.CalculateZeroChecksum(data, 0, headerLen-1)
+	if err != nil {
+		t.Fatalf("CalculateZeroChecksum: %v", err)
+	}
+	data[headerLen-1] = sum
+	if _, err := Unmarshal(data); err == nil {
+		t.Fatal("expected error for unsupported format version, got nil")
+	}
+}
+
+func TestUnmarshalReportsByteOffsetOnAreaChecksumMismatch(t *testing.T) {
+	data, err := Marshal(sampleFRU())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	chassisStart := offsetBytes(data[2])
+	data[chassisStart+2] ^= 0xff // corrupt a content byte, breaking the area checksum
+
+	_, err = Unmarshal(data)
+	if err == nil {
+		t.Fatal("expected error for corrupted chassis area, got nil")
+	}
+	want := "byte offset"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not mention %q", err, want)
+	}
+}
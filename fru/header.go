@@ -0,0 +1,97 @@
+// Package fru parses and builds IPMI Platform Management FRU Information
+// Storage Definition binary images: the common header plus the internal
+// use, chassis info, board info, product info, and multi-record areas.
+package fru
+
+import (
+	"fmt"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+const (
+	headerLen      = 8
+	areaLenUnit    = 8 // all area offsets/lengths are in units of this many bytes
+	formatVersion1 = 0x01
+)
+
+// CommonHeader is the 8-byte FRU common header. Each offset is in units of
+// areaLenUnit (8) bytes from the start of the image; an offset of 0 means
+// the corresponding area is absent.
+type CommonHeader struct {
+	FormatVersion     byte `yaml:"format_version" json:"format_version"`
+	InternalUseOffset byte `yaml:"internal_use_offset" json:"internal_use_offset"`
+	ChassisInfoOffset byte `yaml:"chassis_info_offset" json:"chassis_info_offset"`
+	BoardInfoOffset   byte `yaml:"board_info_offset" json:"board_info_offset"`
+	ProductInfoOffset byte `yaml:"product_info_offset" json:"product_info_offset"`
+	MultiRecordOffset byte `yaml:"multi_record_offset" json:"multi_record_offset"`
+	Checksum          byte `yaml:"checksum" json:"checksum"`
+}
+
+// parseCommonHeader decodes the 8-byte common header from the start of
+// data and validates its zero checksum.
+func parseCommonHeader(data []byte) (CommonHeader, error) {
+	if len(data) < headerLen {
+		return CommonHeader{}, fmt.Errorf("data too short (%d bytes): need at least %d for the common header", len(data), headerLen)
+	}
+	sum, err := fruenc.CalculateZeroChecksum(data, 0, headerLen-1)
+	if err != nil {
+		return CommonHeader{}, fmt.Errorf("computing common header checksum: %w", err)
+	}
+	if got := data[headerLen-1]; sum != got {
+		return CommonHeader{}, fmt.Errorf("common header checksum mismatch: got 0x%02x, want 0x%02x", got, sum)
+	}
+	h := CommonHeader{
+		FormatVersion:     data[0] & 0x0f,
+		InternalUseOffset: data[1],
+		ChassisInfoOffset: data[2],
+		BoardInfoOffset:   data[3],
+		ProductInfoOffset: data[4],
+		MultiRecordOffset: data[5],
+		Checksum:          data[7],
+	}
+	if h.FormatVersion != formatVersion1 {
+		return CommonHeader{}, fmt.Errorf("unsupported common header format version 0x%02x, want 0x%02x", h.FormatVersion, formatVersion1)
+	}
+	if data[6] != 0 {
+		return CommonHeader{}, fmt.Errorf("common header pad byte is 0x%02x, want 0x00", data[6])
+	}
+	return h, nil
+}
+
+// marshal encodes the common header to its 8-byte on-disk form, computing
+// the checksum over the first 7 bytes.
+func (h CommonHeader) marshal() ([]byte, error) {
+	buf := make([]byte, headerLen)
+	buf[0] = formatVersion1
+	buf[1] = h.InternalUseOffset
+	buf[2] = h.ChassisInfoOffset
+	buf[3] = h.BoardInfoOffset
+	buf[4] = h.ProductInfoOffset
+	buf[5] = h.MultiRecordOffset
+	buf[6] = 0
+	sum, err := fruenc.CalculateZeroChecksum(buf, 0, headerLen-1)
+	if err != nil {
+		return nil, fmt.Errorf("computing common header checksum: %w", err)
+	}
+	buf[7] = sum
+	return buf, nil
+}
+
+// offsetBytes returns the byte offset into the image that off (in
+// areaLenUnit units) refers to.
+func offsetBytes(off byte) int {
+	return int(off) * areaLenUnit
+}
+
+// padLen returns how many zero bytes must be appended to n bytes of area
+// content (not counting the trailing checksum byte) so that the area,
+// including its checksum byte, is a multiple of areaLenUnit.
+func padLen(n int) int {
+	total := n + 1 // + checksum byte
+	rem := total % areaLenUnit
+	if rem == 0 {
+		return 0
+	}
+	return areaLenUnit - rem
+}
@@ -0,0 +1,62 @@
+package fru
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HexBytes is raw binary data (an internal use or multi-record payload)
+// that renders as a "0x"-prefixed hex string in a FRU spec, the same way
+// fruenc.TypeLengthField renders its Binary fields, so a dumped spec
+// containing firmware blobs or other opaque payloads stays human-editable
+// instead of exploding into one YAML/JSON list entry per byte.
+type HexBytes []byte
+
+func (b HexBytes) specString() string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func (b *HexBytes) fromSpecString(s string) error {
+	rest, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		return fmt.Errorf("invalid hex data %q: want a 0x-prefixed hex string", s)
+	}
+	raw, err := hex.DecodeString(rest)
+	if err != nil {
+		return fmt.Errorf("invalid hex data %q: %w", s, err)
+	}
+	*b = raw
+	return nil
+}
+
+// MarshalJSON encodes b as a "0x"-prefixed hex string.
+func (b HexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.specString())
+}
+
+// UnmarshalJSON parses b from its "0x"-prefixed hex string form.
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return b.fromSpecString(s)
+}
+
+// MarshalYAML encodes b as a "0x"-prefixed hex string.
+func (b HexBytes) MarshalYAML() (interface{}, error) {
+	return b.specString(), nil
+}
+
+// UnmarshalYAML parses b from its "0x"-prefixed hex string form.
+func (b *HexBytes) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	return b.fromSpecString(s)
+}
@@ -0,0 +1,59 @@
+package fru
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestHexBytesJSONRoundTrip(t *testing.T) {
+	want := HexBytes{0xde, 0xad, 0xbe, 0xef}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if got, want := string(data), `"0xdeadbeef"`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+	var got HexBytes
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("round trip %x -> %x", want, got)
+	}
+}
+
+func TestHexBytesYAMLRoundTrip(t *testing.T) {
+	want := HexBytes{0xde, 0xad, 0xbe, 0xef}
+	data, err := yaml.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got HexBytes
+	if err := yaml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("round trip %x -> %x", want, got)
+	}
+}
+
+func TestHexBytesUnmarshalRejectsMissingPrefix(t *testing.T) {
+	var got HexBytes
+	if err := json.Unmarshal([]byte(`"deadbeef"`), &got); err == nil {
+		t.Fatal("expected error for hex string missing 0x prefix, got nil")
+	}
+}
+
+func TestHexBytesEmpty(t *testing.T) {
+	want := HexBytes{}
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if got, wantStr := string(data), `"0x"`; got != wantStr {
+		t.Errorf("Marshal(empty) = %s, want %s", got, wantStr)
+	}
+}
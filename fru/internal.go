@@ -0,0 +1,32 @@
+package fru
+
+// InternalUseArea holds the internal use area, whose contents are
+// opaque/board-specific: a format version byte followed by raw data. It
+// has no checksum and no area length byte; its extent is implied by the
+// next present area's offset (or the end of the image).
+type InternalUseArea struct {
+	FormatVersion byte     `yaml:"format_version" json:"format_version"`
+	Data          HexBytes `yaml:"data" json:"data"`
+}
+
+// parseInternalUseArea decodes the internal use area from data, which must
+// span exactly the area's bytes (format version byte plus raw data).
+func parseInternalUseArea(data []byte) InternalUseArea {
+	if len(data) == 0 {
+		return InternalUseArea{}
+	}
+	return InternalUseArea{
+		FormatVersion: data[0] & 0x0f,
+		Data:          append([]byte(nil), data[1:]...),
+	}
+}
+
+// marshal encodes the internal use area, padded to a multiple of
+// areaLenUnit bytes.
+func (a InternalUseArea) marshal() []byte {
+	buf := append([]byte{formatVersion1}, a.Data...)
+	if rem := len(buf) % areaLenUnit; rem != 0 {
+		buf = append(buf, make([]byte, areaLenUnit-rem)...)
+	}
+	return buf
+}
@@ -0,0 +1,90 @@
+package fru
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// MultiRecord is one record of the multi-record area.
+type MultiRecord struct {
+	RecordTypeID  byte     `yaml:"record_type_id" json:"record_type_id"`
+	FormatVersion byte     `yaml:"format_version" json:"format_version"`
+	Data          HexBytes `yaml:"data" json:"data"`
+}
+
+// recordChecksum computes the zero checksum of data.
+func recordChecksum(data []byte) byte {
+	z := fruenc.NewZeroChecksum()
+	z.Write(data)
+	return z.Sum(nil)[0]
+}
+
+// parseMultiRecordArea decodes the sequence of multi-record entries
+// starting at byte offset start in image, stopping at the record whose
+// end-of-list bit is set.
+func parseMultiRecordArea(image []byte, start int) ([]MultiRecord, error) {
+	var records []MultiRecord
+	pos := start
+	for {
+		if pos+5 > len(image) {
+			return nil, fmt.Errorf("multi-record header at byte %d is truncated", pos)
+		}
+		hdr := image[pos : pos+5]
+		hsum, err := fruenc.CalculateZeroChecksum(hdr, 0, 4)
+		if err != nil {
+			return nil, fmt.Errorf("computing multi-record header checksum at byte %d: %w", pos, err)
+		}
+		if got := hdr[4]; hsum != got {
+			return nil, fmt.Errorf("multi-record header checksum mismatch at byte %d: got 0x%02x, want 0x%02x", pos, got, hsum)
+		}
+		length := int(hdr[2])
+		end := pos + 5 + length
+		if end > len(image) {
+			return nil, fmt.Errorf("multi-record at byte %d declares length %d, exceeding the %d-byte image", pos, length, len(image))
+		}
+		data := image[pos+5 : end]
+		rsum := recordChecksum(data)
+		if got := hdr[3]; rsum != got {
+			return nil, fmt.Errorf("multi-record checksum mismatch at byte %d: got 0x%02x, want 0x%02x", pos, got, rsum)
+		}
+		records = append(records, MultiRecord{
+			RecordTypeID:  hdr[0],
+			FormatVersion: hdr[1] & 0x0f,
+			Data:          append([]byte(nil), data...),
+		})
+		pos = end
+		if hdr[1]&0x80 != 0 { // end-of-list bit
+			break
+		}
+	}
+	return records, nil
+}
+
+// marshalMultiRecords encodes records as the multi-record area, setting the
+// end-of-list bit on the last record's header.
+func marshalMultiRecords(records []MultiRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, r := range records {
+		if len(r.Data) > 0xff {
+			return nil, fmt.Errorf("multi-record #%d data too long (%d bytes): max is 255", i, len(r.Data))
+		}
+		hdr := make([]byte, 5)
+		hdr[0] = r.RecordTypeID
+		hdr[1] = r.FormatVersion & 0x0f
+		if i == len(records)-1 {
+			hdr[1] |= 0x80 // end-of-list bit
+		}
+		hdr[2] = byte(len(r.Data))
+		hdr[3] = recordChecksum(r.Data)
+
+		hc := fruenc.NewZeroChecksum()
+		hc.Write(hdr[:4])
+		hdr[4] = hc.Sum(nil)[0]
+
+		buf.Write(hdr)
+		buf.Write(r.Data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,37 @@
+package fru
+
+import "testing"
+
+func TestMultiRecordRoundTrip(t *testing.T) {
+	want := []MultiRecord{
+		{RecordTypeID: 0x01, FormatVersion: 0x02, Data: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{RecordTypeID: 0x02, FormatVersion: 0x02, Data: []byte{}},
+	}
+	encoded, err := marshalMultiRecords(want)
+	if err != nil {
+		t.Fatalf("marshalMultiRecords: %v", err)
+	}
+	got, err := parseMultiRecordArea(encoded, 0)
+	if err != nil {
+		t.Fatalf("parseMultiRecordArea: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d records, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].RecordTypeID != want[i].RecordTypeID || string(got[i].Data) != string(want[i].Data) {
+			t.Errorf("record #%d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseMultiRecordAreaChecksumMismatch(t *testing.T) {
+	encoded, err := marshalMultiRecords([]MultiRecord{{RecordTypeID: 0x01, Data: []byte{0x01, 0x02}}})
+	if err != nil {
+		t.Fatalf("marshalMultiRecords: %v", err)
+	}
+	encoded[5] ^= 0xff // corrupt the record data, breaking its checksum
+	if _, err := parseMultiRecordArea(encoded, 0); err == nil {
+		t.Fatal("expected error for corrupted multi-record data, got nil")
+	}
+}
@@ -0,0 +1,91 @@
+package fru
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hclihn/FRU-tool/fruenc"
+)
+
+// ProductInfoArea is the product info area.
+type ProductInfoArea struct {
+	LanguageCode byte                     `yaml:"language_code" json:"language_code"`
+	Manufacturer fruenc.TypeLengthField   `yaml:"manufacturer" json:"manufacturer"`
+	ProductName  fruenc.TypeLengthField   `yaml:"product_name" json:"product_name"`
+	PartNumber   fruenc.TypeLengthField   `yaml:"part_number" json:"part_number"`
+	Version      fruenc.TypeLengthField   `yaml:"version" json:"version"`
+	SerialNumber fruenc.TypeLengthField   `yaml:"serial_number" json:"serial_number"`
+	AssetTag     fruenc.TypeLengthField   `yaml:"asset_tag" json:"asset_tag"`
+	FRUFileID    fruenc.TypeLengthField   `yaml:"fru_file_id" json:"fru_file_id"`
+	CustomFields []fruenc.TypeLengthField `yaml:"custom_fields,omitempty" json:"custom_fields,omitempty"`
+}
+
+// parseProductInfoArea decodes a product info area. area must be exactly
+// the area's bytes (format version through checksum, inclusive), with its
+// checksum already validated by the caller.
+func parseProductInfoArea(area []byte) (ProductInfoArea, error) {
+	if len(area) < 3 {
+		return ProductInfoArea{}, fmt.Errorf("product info area too short (%d bytes)", len(area))
+	}
+	var a ProductInfoArea
+	a.LanguageCode = area[2]
+
+	r := bytes.NewReader(area[3 : len(area)-1])
+	var err error
+	if a.Manufacturer, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product manufacturer: %w", err)
+	}
+	if a.ProductName, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product name: %w", err)
+	}
+	if a.PartNumber, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product part/model number: %w", err)
+	}
+	if a.Version, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product version: %w", err)
+	}
+	if a.SerialNumber, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product serial number: %w", err)
+	}
+	if a.AssetTag, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product asset tag: %w", err)
+	}
+	if a.FRUFileID, err = fruenc.ParseTypeLength(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product FRU file ID: %w", err)
+	}
+	if a.CustomFields, err = readCustomFields(r, a.LanguageCode); err != nil {
+		return ProductInfoArea{}, fmt.Errorf("reading product custom fields: %w", err)
+	}
+	return a, nil
+}
+
+// marshal encodes the product info area, including its format version,
+// length, and checksum bytes.
+func (a ProductInfoArea) marshal() ([]byte, error) {
+	buf := []byte{formatVersion1, 0, a.LanguageCode}
+	var b bytes.Buffer
+	fields := []struct {
+		name string
+		f    fruenc.TypeLengthField
+	}{
+		{"manufacturer", a.Manufacturer},
+		{"product name", a.ProductName},
+		{"part/model number", a.PartNumber},
+		{"version", a.Version},
+		{"serial number", a.SerialNumber},
+		{"asset tag", a.AssetTag},
+		{"FRU file ID", a.FRUFileID},
+	}
+	for _, fd := range fields {
+		if err := fd.f.Marshal(&b, a.LanguageCode); err != nil {
+			return nil, fmt.Errorf("writing product %s: %w", fd.name, err)
+		}
+	}
+	for i, f := range a.CustomFields {
+		if err := f.Marshal(&b, a.LanguageCode); err != nil {
+			return nil, fmt.Errorf("writing product custom field #%d: %w", i, err)
+		}
+	}
+	buf = append(buf, b.Bytes()...)
+	return finishArea(buf)
+}
@@ -0,0 +1,197 @@
+// Package fruenc implements the low-level byte encodings used by IPMI FRU
+// (Field Replaceable Unit) data: BCD Plus and Packed 6-bit ASCII, plus the
+// zero-checksum used to validate FRU areas.
+package fruenc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	bcdPlusCodes = "0123456789 -."
+	bcdPlusSP    = 10 // BCD Plus space code
+)
+
+// BCDPlusBytes stores the BCD+ encoded BCDPlusBytes.
+// Code mapping: '0'-'9' <-> 0x0-0x9, SP <-> 0xa, '-' <-> 0xb, '.' <-> 0xc.
+type BCDPlusBytes []byte
+
+// BCDPlusEncodedLen returns the length in bytes of the BCD+ encoding of n
+// source bytes (two source bytes are packed into each encoded byte).
+func BCDPlusEncodedLen(n int) int {
+	return (n + 1) / 2
+}
+
+// BCDPlusDecodedLen returns the length in bytes of the data decoded from n
+// BCD+ encoded bytes.
+func BCDPlusDecodedLen(n int) int {
+	return n * 2
+}
+
+// EncodeBCDPlus encodes src into BCD+ form, writing BCDPlusEncodedLen(len(src))
+// bytes to dst. It returns the number of bytes written. dst must be at
+// least that long, or an error is returned. If len(src) is odd, the final
+// nibble is padded with the BCD+ space code. EncodeBCDPlus does not
+// allocate.
+func EncodeBCDPlus(dst, src []byte) (int, error) {
+	n := BCDPlusEncodedLen(len(src))
+	if len(dst) < n {
+		return 0, fmt.Errorf("dst too short (%d): need at least %d bytes", len(dst), n)
+	}
+	lSrc := len(src)
+	for i := 0; i < n; i++ {
+		code := 0
+		for j := 0; j < 2; j++ {
+			sIdx := 2*i + j
+			idx := bcdPlusSP
+			if sIdx < lSrc {
+				sb := src[sIdx]
+				k := strings.IndexByte(bcdPlusCodes, sb)
+				if k < 0 {
+					return 0, fmt.Errorf("invalid char %q for BCD Plus encoding at index %d of %q", sb, sIdx, src)
+				}
+				idx = k
+			}
+			code = code*16 + idx
+		}
+		dst[i] = byte(code)
+	}
+	return n, nil
+}
+
+// DecodeBCDPlus decodes BCD+ encoded src into dst, writing
+// BCDPlusDecodedLen(len(src)) bytes. It returns the number of bytes
+// written. dst must be at least that long, or an error is returned.
+// DecodeBCDPlus does not allocate and does not trim trailing spaces; callers
+// that want trimming should trim dst themselves.
+func DecodeBCDPlus(dst, src []byte) (int, error) {
+	n := BCDPlusDecodedLen(len(src))
+	if len(dst) < n {
+		return 0, fmt.Errorf("dst too short (%d): need at least %d bytes", len(dst), n)
+	}
+	for i, sb := range src {
+		for j := 0; j < 2; j++ {
+			v := (int(sb) >> ((1 - j) * 4)) & 0x0f
+			if v >= len(bcdPlusCodes) {
+				loc := "upper"
+				if j > 0 {
+					loc = "lower"
+				}
+				return 0, fmt.Errorf("invalide BCD Plus code (%d) in %s nibble of byte #%d of %q", v, loc, i, src)
+			}
+			dst[i*2+j] = bcdPlusCodes[v]
+		}
+	}
+	return n, nil
+}
+
+// Decode decodes the BCD+ encoded bytes and returns the decoded []byte.
+// trim indicates if the trailing spaces should be trimmed.
+// An error is returned if it contains any invalid BCD+ char.
+func (b BCDPlusBytes) Decode(trim bool) ([]byte, error) {
+	dest := make([]byte, BCDPlusDecodedLen(len(b)))
+	if _, err := DecodeBCDPlus(dest, b); err != nil {
+		return nil, err
+	}
+	if trim {
+		return bytes.TrimRight(dest, " "), nil
+	}
+	return dest, nil
+}
+
+// Encode encodes the src []byte to BCD+ encoded form.
+// The bool returned indicates if the padded space is added (src length is not even).
+// An error is returned if src contains invalid BCD+ char.
+func (b *BCDPlusBytes) Encode(src []byte) (bool, error) {
+	*b = make([]byte, BCDPlusEncodedLen(len(src)))
+	if _, err := EncodeBCDPlus(*b, src); err != nil {
+		return false, err
+	}
+	return len(src)%2 != 0, nil
+}
+
+// BCDPlusEncoder is an io.WriteCloser that BCD+ encodes bytes written to it,
+// buffering an odd trailing source byte across Write calls and flushing it
+// (padded with the BCD+ space code) on Close. It is analogous to the
+// encoders in encoding/hex and encoding/base64.
+type BCDPlusEncoder struct {
+	w           io.Writer
+	pending     byte
+	havePending bool
+	padded      bool
+	closed      bool
+}
+
+// NewBCDPlusEncoder returns a BCDPlusEncoder that writes BCD+ encoded data to w.
+func NewBCDPlusEncoder(w io.Writer) *BCDPlusEncoder {
+	return &BCDPlusEncoder{w: w}
+}
+
+// Write BCD+ encodes p and writes the result to the underlying writer,
+// buffering a trailing odd byte until the next Write or Close.
+func (e *BCDPlusEncoder) Write(p []byte) (int, error) {
+	n := len(p)
+	if e.havePending {
+		p = append([]byte{e.pending}, p...)
+		e.havePending = false
+	}
+	if len(p)%2 != 0 {
+		e.pending = p[len(p)-1]
+		e.havePending = true
+		p = p[:len(p)-1]
+	}
+	if len(p) == 0 {
+		return n, nil
+	}
+	buf := make([]byte, BCDPlusEncodedLen(len(p)))
+	if _, err := EncodeBCDPlus(buf, p); err != nil {
+		return 0, err
+	}
+	if _, err := e.w.Write(buf); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Close flushes any buffered trailing byte, padding it with the BCD+ space
+// code, and reports whether padding was added via Padded. Close must be
+// called to flush the final nibble of an odd-length stream.
+func (e *BCDPlusEncoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if !e.havePending {
+		return nil
+	}
+	e.padded = true
+	buf := make([]byte, 1)
+	if _, err := EncodeBCDPlus(buf, []byte{e.pending}); err != nil {
+		return err
+	}
+	_, err := e.w.Write(buf)
+	return err
+}
+
+// Padded reports whether Close had to pad a trailing nibble with the BCD+
+// space code. It is only meaningful after Close has been called.
+func (e *BCDPlusEncoder) Padded() bool {
+	return e.padded
+}
+
+// NewBCDPlusDecoder returns an io.Reader that reads BCD+ encoded data from r
+// and returns the decoded bytes (two decoded bytes per encoded byte,
+// untrimmed). It buffers across short reads from r the same way
+// encoding/base64's decoder does, so it is safe to use with readers that
+// return less data than requested.
+func NewBCDPlusDecoder(r io.Reader) io.Reader {
+	return &blockDecoder{
+		r:          r,
+		srcBlock:   1,
+		decode:     DecodeBCDPlus,
+		decodedLen: BCDPlusDecodedLen,
+	}
+}
@@ -0,0 +1,89 @@
+package fruenc
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestBCDPlusRoundTrip(t *testing.T) {
+	cases := []string{"", "0", "123-456-7.890", "1234"}
+	for _, src := range cases {
+		var b BCDPlusBytes
+		padded, err := b.Encode([]byte(src))
+		if err != nil {
+			t.Fatalf("Encode(%q): %v", src, err)
+		}
+		if want := len(src)%2 != 0; padded != want {
+			t.Errorf("Encode(%q) padded = %v, want %v", src, padded, want)
+		}
+		got, err := b.Decode(true)
+		if err != nil {
+			t.Fatalf("Decode after Encode(%q): %v", src, err)
+		}
+		if string(got) != src {
+			t.Errorf("round trip %q -> %q", src, got)
+		}
+	}
+}
+
+func TestEncodeBCDPlusInvalidChar(t *testing.T) {
+	dst := make([]byte, BCDPlusEncodedLen(1))
+	if _, err := EncodeBCDPlus(dst, []byte("!")); err == nil {
+		t.Fatal("expected error for invalid BCD+ char, got nil")
+	}
+}
+
+// oneByteReader wraps a reader so every Read call returns at most one byte,
+// exercising decoders that must buffer across short reads.
+type oneByteReader struct {
+	r io.Reader
+}
+
+func (o oneByteReader) Read(p []byte) (int, error) {
+	if len(p) > 1 {
+		p = p[:1]
+	}
+	return o.r.Read(p)
+}
+
+func TestBCDPlusDecoderShortReads(t *testing.T) {
+	const want = "123-456-7.890"
+	var b BCDPlusBytes
+	if _, err := b.Encode([]byte(want)); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	dec := NewBCDPlusDecoder(oneByteReader{bytes.NewReader(b)})
+	got, err := io.ReadAll(dec)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if strings.TrimRight(string(got), " ") != want {
+		t.Errorf("decoded %q, want %q", got, want)
+	}
+}
+
+func TestBCDPlusDecoderSmallDestBuffer(t *testing.T) {
+	const want = "123-456-7.890"
+	var b BCDPlusBytes
+	if _, err := b.Encode([]byte(want)); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	dec := NewBCDPlusDecoder(bytes.NewReader(b))
+	var got []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := dec.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+	}
+	if strings.TrimRight(string(got), " ") != want {
+		t.Errorf("decoded %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+package fruenc
+
+import (
+	"fmt"
+	"hash"
+)
+
+// ZeroChecksum incrementally computes the IPMI "zero checksum": the byte
+// that, appended to the bytes written so far, makes their sum equal zero
+// modulo 256. It implements the hash.Hash interface (the part of it that
+// makes sense for a 1-byte checksum), so a FRU area writer can compute the
+// trailing checksum byte as it emits header and fields via
+// io.MultiWriter(dst, checksum), rather than buffering the whole area to
+// call CalculateZeroChecksum afterward.
+type ZeroChecksum struct {
+	sum byte
+}
+
+var _ hash.Hash = (*ZeroChecksum)(nil)
+
+// NewZeroChecksum returns a ZeroChecksum ready to accumulate bytes.
+func NewZeroChecksum() *ZeroChecksum {
+	return &ZeroChecksum{}
+}
+
+// Write adds p to the running sum. It never returns an error.
+func (z *ZeroChecksum) Write(p []byte) (int, error) {
+	for _, b := range p {
+		z.sum += b // this will always truncate it to byte
+	}
+	return len(p), nil
+}
+
+// Sum appends the current zero checksum byte to b and returns the
+// resulting slice, without altering the checksum's state.
+func (z *ZeroChecksum) Sum(b []byte) []byte {
+	return append(b, ^z.sum+1) // 2's complement of the running sum
+}
+
+// Reset zeroes the running sum.
+func (z *ZeroChecksum) Reset() {
+	z.sum = 0
+}
+
+// Size returns the number of bytes Sum appends: always 1.
+func (z *ZeroChecksum) Size() int {
+	return 1
+}
+
+// BlockSize returns the checksum's natural block size: always 1, since it
+// operates a byte at a time.
+func (z *ZeroChecksum) BlockSize() int {
+	return 1
+}
+
+// CalculateZeroChecksum computes the IPMI "zero checksum" byte for
+// data[start : start+nBytes], i.e. the byte that, appended to the range,
+// makes the sum of all bytes in the range (including the checksum byte)
+// equal to zero modulo 256. It is a thin wrapper around ZeroChecksum for
+// callers that already have the whole range in memory.
+func CalculateZeroChecksum(data []byte, start, nBytes int) (byte, error) {
+	lData := len(data)
+	if start < 0 || start >= lData {
+		return 0, fmt.Errorf("invalid start value (%d): expected in [0...%d]",
+			start, lData-1)
+	} else if nBytes < 0 || nBytes > lData-start {
+		return 0, fmt.Errorf("invalid nBytes value (%d): expected in [0...%d]",
+			nBytes, lData-start)
+	}
+	z := NewZeroChecksum()
+	z.Write(data[start : start+nBytes])
+	return z.Sum(nil)[0], nil
+}
@@ -0,0 +1,29 @@
+package fruenc
+
+import "testing"
+
+func TestCalculateZeroChecksum(t *testing.T) {
+	data := []byte{0x01, 0x02, 0x03, 0x00}
+	sum, err := CalculateZeroChecksum(data, 0, 3)
+	if err != nil {
+		t.Fatalf("CalculateZeroChecksum: %v", err)
+	}
+	data[3] = sum
+	var total byte
+	for _, b := range data {
+		total += b
+	}
+	if total != 0 {
+		t.Errorf("data with checksum sums to %d, want 0", total)
+	}
+}
+
+func TestCalculateZeroChecksumInvalidRange(t *testing.T) {
+	data := []byte{0x01, 0x02}
+	if _, err := CalculateZeroChecksum(data, -1, 1); err == nil {
+		t.Error("expected error for negative start, got nil")
+	}
+	if _, err := CalculateZeroChecksum(data, 0, 5); err == nil {
+		t.Error("expected error for nBytes exceeding data, got nil")
+	}
+}
@@ -0,0 +1,194 @@
+package fruenc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const (
+	firstPacked6BitAscii = 0x20 // ASCII space code
+	lastPacked6BitAscii  = 0x5f // ASCII '_' code
+)
+
+// Packed6BitAsciiBytes stores Packed 6-bit ASCII encoded bytes: four source
+// ASCII characters (0x20-0x5f) are packed into three encoded bytes.
+type Packed6BitAsciiBytes []byte
+
+// Packed6BitAsciiEncodedLen returns the length in bytes of the Packed 6-bit
+// ASCII encoding of n source bytes.
+func Packed6BitAsciiEncodedLen(n int) int {
+	return (n/4)*3 + (n % 4)
+}
+
+// Packed6BitAsciiDecodedLen returns the length in bytes of the data decoded
+// from n Packed 6-bit ASCII encoded bytes.
+func Packed6BitAsciiDecodedLen(n int) int {
+	return (n/3)*4 + (n % 3)
+}
+
+// EncodePacked6BitAscii encodes src into Packed 6-bit ASCII form, writing
+// Packed6BitAsciiEncodedLen(len(src)) bytes to dst. It returns the number of
+// bytes written. dst must be at least that long, or an error is returned.
+// EncodePacked6BitAscii does not allocate.
+func EncodePacked6BitAscii(dst, src []byte) (int, error) {
+	n := Packed6BitAsciiEncodedLen(len(src))
+	if len(dst) < n {
+		return 0, fmt.Errorf("dst too short (%d): need at least %d bytes", len(dst), n)
+	}
+	j := 0
+	var acc byte
+	for i, sb := range src {
+		if sb < firstPacked6BitAscii || sb > lastPacked6BitAscii {
+			return 0, fmt.Errorf("invalid char %q for Packed 6-bit ASCII encoding at index %d of %q", sb, i, src)
+		}
+		sb -= firstPacked6BitAscii
+		switch i % 4 {
+		case 0:
+			acc = sb & 0x3f // 6 bits
+		case 1:
+			dst[j] = (sb&0x03)<<6 | acc
+			acc = (sb >> 2) & 0x0f // 4 bits
+			j++
+		case 2:
+			dst[j] = (sb&0x0f)<<4 | acc
+			acc = (sb >> 4) & 0x03 // 2 bits
+			j++
+		case 3:
+			dst[j] = (sb&0x3f)<<2 | acc
+			acc = 0
+			j++
+		}
+	}
+	if len(src)%4 != 0 {
+		dst[j] = acc
+	}
+	return n, nil
+}
+
+// DecodePacked6BitAscii decodes Packed 6-bit ASCII encoded src into dst,
+// writing Packed6BitAsciiDecodedLen(len(src)) bytes. It returns the number
+// of bytes written. dst must be at least that long, or an error is
+// returned. DecodePacked6BitAscii does not allocate and does not trim
+// trailing spaces.
+func DecodePacked6BitAscii(dst, src []byte) (int, error) {
+	n := Packed6BitAsciiDecodedLen(len(src))
+	if len(dst) < n {
+		return 0, fmt.Errorf("dst too short (%d): need at least %d bytes", len(dst), n)
+	}
+	var remain byte
+	j := 0
+	for i, sb := range src {
+		var v byte
+		switch i % 3 {
+		case 0:
+			v = sb & 0x3f
+			remain = (sb >> 6) & 0x03
+		case 1:
+			v = (sb&0x0f)<<2 | remain
+			remain = (sb >> 4) & 0x0f
+		case 2:
+			v = (sb&0x03)<<4 | remain
+			dst[j] = v + firstPacked6BitAscii
+			j++
+			v = (sb >> 2) & 0x3f
+			remain = 0
+		}
+		dst[j] = v + firstPacked6BitAscii
+		j++
+	}
+	return n, nil
+}
+
+func (p Packed6BitAsciiBytes) Decode(trim bool) ([]byte, error) {
+	dest := make([]byte, Packed6BitAsciiDecodedLen(len(p)))
+	if _, err := DecodePacked6BitAscii(dest, p); err != nil {
+		return nil, err
+	}
+	if trim {
+		return bytes.TrimRight(dest, " "), nil
+	}
+	return dest, nil
+}
+
+func (p *Packed6BitAsciiBytes) Encode(src []byte) error {
+	*p = make([]byte, Packed6BitAsciiEncodedLen(len(src)))
+	_, err := EncodePacked6BitAscii(*p, src)
+	return err
+}
+
+// Packed6BitAsciiEncoder is an io.WriteCloser that Packed 6-bit ASCII
+// encodes bytes written to it, buffering a partial group of up to three
+// pending source bytes across Write calls and flushing it on Close.
+type Packed6BitAsciiEncoder struct {
+	w       io.Writer
+	pending []byte // 0-3 buffered source bytes
+	padded  bool
+	closed  bool
+}
+
+// NewPacked6BitAsciiEncoder returns a Packed6BitAsciiEncoder that writes
+// Packed 6-bit ASCII encoded data to w.
+func NewPacked6BitAsciiEncoder(w io.Writer) *Packed6BitAsciiEncoder {
+	return &Packed6BitAsciiEncoder{w: w}
+}
+
+func (e *Packed6BitAsciiEncoder) Write(p []byte) (int, error) {
+	n := len(p)
+	buf := append(e.pending, p...)
+	full := (len(buf) / 4) * 4
+	e.pending = append([]byte(nil), buf[full:]...)
+	buf = buf[:full]
+	if len(buf) == 0 {
+		return n, nil
+	}
+	dst := make([]byte, Packed6BitAsciiEncodedLen(len(buf)))
+	if _, err := EncodePacked6BitAscii(dst, buf); err != nil {
+		return 0, err
+	}
+	if _, err := e.w.Write(dst); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Close flushes any buffered partial group (1-3 source bytes) as 1-3
+// encoded bytes, zero-filling the unused bits of the last one, and reports
+// whether padding was added via Padded.
+func (e *Packed6BitAsciiEncoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if len(e.pending) == 0 {
+		return nil
+	}
+	e.padded = true
+	dst := make([]byte, Packed6BitAsciiEncodedLen(len(e.pending)))
+	if _, err := EncodePacked6BitAscii(dst, e.pending); err != nil {
+		return err
+	}
+	_, err := e.w.Write(dst)
+	return err
+}
+
+// Padded reports whether Close had to pad a trailing partial group. It is
+// only meaningful after Close has been called.
+func (e *Packed6BitAsciiEncoder) Padded() bool {
+	return e.padded
+}
+
+// NewPacked6BitAsciiDecoder returns an io.Reader that reads Packed 6-bit
+// ASCII encoded data from r and returns the decoded bytes (untrimmed). It
+// buffers across short reads from r the same way encoding/base64's
+// decoder does, so it is safe to use with readers that return less data
+// than requested — important here since a 3-byte encoded group must stay
+// aligned across Read calls.
+func NewPacked6BitAsciiDecoder(r io.Reader) io.Reader {
+	return &blockDecoder{
+		r:          r,
+		srcBlock:   3,
+		decode:     DecodePacked6BitAscii,
+		decodedLen: Packed6BitAsciiDecodedLen,
+	}
+}
@@ -0,0 +1,47 @@
+package fruenc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestPacked6BitAsciiRoundTrip(t *testing.T) {
+	cases := []string{"", "A", "IPMITOOL TEST STRING 1234", "ABCD"}
+	for _, src := range cases {
+		var p Packed6BitAsciiBytes
+		if err := p.Encode([]byte(src)); err != nil {
+			t.Fatalf("Encode(%q): %v", src, err)
+		}
+		got, err := p.Decode(true)
+		if err != nil {
+			t.Fatalf("Decode after Encode(%q): %v", src, err)
+		}
+		if string(got) != src {
+			t.Errorf("round trip %q -> %q", src, got)
+		}
+	}
+}
+
+func TestEncodePacked6BitAsciiInvalidChar(t *testing.T) {
+	dst := make([]byte, Packed6BitAsciiEncodedLen(1))
+	if _, err := EncodePacked6BitAscii(dst, []byte{0x00}); err == nil {
+		t.Fatal("expected error for out-of-range char, got nil")
+	}
+}
+
+func TestPacked6BitAsciiDecoderShortReads(t *testing.T) {
+	const want = "IPMITOOL TEST STRING 1234"
+	var p Packed6BitAsciiBytes
+	if err := p.Encode([]byte(want)); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	dec := NewPacked6BitAsciiDecoder(oneByteReader{bytes.NewReader(p)})
+	got, err := io.ReadAll(dec)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("decoded %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,65 @@
+package fruenc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// specString renders f the way a hand-authored FRU spec represents a
+// field: its decoded text, or a "0x"-prefixed hex string for Binary
+// fields. The Empty and EndOfFields sentinels never appear in a spec (they
+// are implicit in an empty/absent value and in ending the field list).
+func (f TypeLengthField) specString() string {
+	if f.Type == FieldTypeBinary {
+		return "0x" + hex.EncodeToString(f.Raw)
+	}
+	return f.Value
+}
+
+// fromSpecString parses s in the same form specString produces.
+func (f *TypeLengthField) fromSpecString(s string) error {
+	if rest, ok := strings.CutPrefix(s, "0x"); ok {
+		raw, err := hex.DecodeString(rest)
+		if err != nil {
+			return fmt.Errorf("invalid hex field %q: %w", s, err)
+		}
+		*f = TypeLengthField{Type: FieldTypeBinary, Raw: raw}
+		return nil
+	}
+	*f = TypeLengthField{Type: FieldTypeText, Value: s}
+	return nil
+}
+
+// MarshalJSON encodes f as its spec string, so a dumped FRU can be edited
+// as plain JSON and fed back into Unmarshal/create.
+func (f TypeLengthField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.specString())
+}
+
+// UnmarshalJSON parses f from its spec string form.
+func (f *TypeLengthField) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return f.fromSpecString(s)
+}
+
+// MarshalYAML encodes f as its spec string, so a dumped FRU can be edited
+// as plain YAML and fed back into Unmarshal/create.
+func (f TypeLengthField) MarshalYAML() (interface{}, error) {
+	return f.specString(), nil
+}
+
+// UnmarshalYAML parses f from its spec string form.
+func (f *TypeLengthField) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	return f.fromSpecString(s)
+}
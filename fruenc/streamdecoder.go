@@ -0,0 +1,55 @@
+package fruenc
+
+import "io"
+
+// blockDecoder is a generic io.Reader that decodes a block-oriented
+// encoding (groups of srcBlock encoded bytes) read from an underlying
+// reader. Like encoding/base64's decoder, it buffers encoded bytes that
+// don't yet form a complete group and decoded bytes that don't yet fit in
+// the caller's buffer across Read calls, since the underlying reader is
+// free to return short reads at any boundary. At EOF, any final partial
+// group (fewer than srcBlock bytes) is still decoded, matching the
+// trailing-partial-group behavior of the non-streaming Decode functions.
+type blockDecoder struct {
+	r          io.Reader
+	srcBlock   int
+	decode     func(dst, src []byte) (int, error)
+	decodedLen func(n int) int
+
+	raw     [4096]byte
+	partial []byte // leftover encoded bytes not yet decoded
+	pending []byte // decoded bytes not yet returned to the caller
+	err     error  // sticky error, returned once pending is drained
+}
+
+func (d *blockDecoder) Read(p []byte) (int, error) {
+	for len(d.pending) == 0 && d.err == nil {
+		n, rerr := d.r.Read(d.raw[:])
+		if n > 0 {
+			d.partial = append(d.partial, d.raw[:n]...)
+		}
+
+		toDecode := (len(d.partial) / d.srcBlock) * d.srcBlock
+		if rerr != nil && len(d.partial) > toDecode {
+			toDecode = len(d.partial) // EOF: decode the trailing partial group too
+		}
+		if toDecode > 0 {
+			dst := make([]byte, d.decodedLen(toDecode))
+			if _, derr := d.decode(dst, d.partial[:toDecode]); derr != nil {
+				d.err = derr
+				break
+			}
+			d.pending = dst
+			d.partial = append([]byte(nil), d.partial[toDecode:]...)
+		}
+		if rerr != nil {
+			d.err = rerr
+		}
+	}
+	if len(d.pending) == 0 {
+		return 0, d.err
+	}
+	n := copy(p, d.pending)
+	d.pending = d.pending[n:]
+	return n, nil
+}
@@ -0,0 +1,237 @@
+package fruenc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// FieldType identifies which of the four IPMI FRU type/length encodings a
+// TypeLengthField was decoded from, as given by the top 2 bits of the
+// type/length byte.
+type FieldType byte
+
+const (
+	// FieldTypeBinary is raw/unspecified binary data (type bits 00).
+	FieldTypeBinary FieldType = iota
+	// FieldTypeBCDPlus is BCD Plus encoded data (type bits 01).
+	FieldTypeBCDPlus
+	// FieldTypePacked6BitAscii is Packed 6-bit ASCII encoded data (type bits 10).
+	FieldTypePacked6BitAscii
+	// FieldTypeText is 8-bit ASCII+Latin1 (English area language code) or
+	// 2-byte UTF-16LE Unicode (any other language code) data (type bits 11).
+	FieldTypeText
+)
+
+const (
+	// emptyFieldByte is the "empty field" sentinel: type bits 11, length 0.
+	emptyFieldByte = 0xc0
+	// endOfFieldsByte is the "end of fields" sentinel that terminates a
+	// sequence of type/length fields within an area.
+	endOfFieldsByte = 0xc1
+
+	maxFieldLen = 0x3f // 6-bit length field
+)
+
+// TypeLengthField is a decoded IPMI FRU "type/length" field: the
+// type/length byte plus the data that follows it.
+type TypeLengthField struct {
+	Type FieldType
+	// Value holds the decoded text for BCDPlus, Packed6BitAscii, and Text
+	// fields. It is unused for Binary fields.
+	Value string
+	// Raw holds the raw field bytes for Binary fields. It is unused for
+	// other field types.
+	Raw []byte
+	// Empty is true for the 0xc0 "empty field" sentinel; no other field is
+	// meaningful when Empty is true.
+	Empty bool
+	// EndOfFields is true for the 0xc1 "end of fields" sentinel that
+	// terminates a sequence of fields; no other field is meaningful when
+	// EndOfFields is true.
+	EndOfFields bool
+}
+
+// ParseTypeLength reads one IPMI FRU type/length byte from r, followed by
+// its data, and decodes it according to the top 2 type bits. lang is the
+// language code of the enclosing area, which selects between 8-bit
+// ASCII+Latin1 and UTF-16LE for type-11b (FieldTypeText) fields; lang is
+// ignored for the other three types.
+func ParseTypeLength(r io.Reader, lang byte) (TypeLengthField, error) {
+	var tl [1]byte
+	if _, err := io.ReadFull(r, tl[:]); err != nil {
+		return TypeLengthField{}, fmt.Errorf("reading type/length byte: %w", err)
+	}
+	b := tl[0]
+	if b == endOfFieldsByte {
+		return TypeLengthField{EndOfFields: true}, nil
+	}
+	if b == emptyFieldByte {
+		return TypeLengthField{Empty: true}, nil
+	}
+	typeBits := b >> 6
+	length := int(b & maxFieldLen)
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return TypeLengthField{}, fmt.Errorf("reading %d bytes of type/length field data: %w", length, err)
+	}
+	switch typeBits {
+	case 0:
+		return TypeLengthField{Type: FieldTypeBinary, Raw: data}, nil
+	case 1:
+		v, err := BCDPlusBytes(data).Decode(true)
+		if err != nil {
+			return TypeLengthField{}, fmt.Errorf("decoding BCD Plus field: %w", err)
+		}
+		return TypeLengthField{Type: FieldTypeBCDPlus, Value: string(v)}, nil
+	case 2:
+		v, err := Packed6BitAsciiBytes(data).Decode(true)
+		if err != nil {
+			return TypeLengthField{}, fmt.Errorf("decoding Packed 6-bit ASCII field: %w", err)
+		}
+		return TypeLengthField{Type: FieldTypePacked6BitAscii, Value: string(v)}, nil
+	default: // 3
+		if lang == 0 {
+			return TypeLengthField{Type: FieldTypeText, Value: decodeLatin1(data)}, nil
+		}
+		v, err := decodeUTF16LE(data)
+		if err != nil {
+			return TypeLengthField{}, fmt.Errorf("decoding Unicode field: %w", err)
+		}
+		return TypeLengthField{Type: FieldTypeText, Value: v}, nil
+	}
+}
+
+// Marshal writes f's type/length byte and field data to w. For the Empty
+// and EndOfFields sentinels, it writes just the sentinel byte. For Binary
+// fields, it writes Raw verbatim. For the text-bearing field types, it
+// ignores f.Type and instead picks the most compact encoding that can
+// represent f.Value: BCD Plus, then Packed 6-bit ASCII, then (depending on
+// lang) 8-bit ASCII+Latin1 or UTF-16LE.
+func (f TypeLengthField) Marshal(w io.Writer, lang byte) error {
+	if f.EndOfFields {
+		_, err := w.Write([]byte{endOfFieldsByte})
+		return err
+	}
+	if f.Empty {
+		_, err := w.Write([]byte{emptyFieldByte})
+		return err
+	}
+	if f.Type == FieldTypeBinary {
+		return writeTypeLength(w, 0, f.Raw)
+	}
+	return marshalText(w, f.Value, lang)
+}
+
+// writeTypeLength writes the type/length byte for typeBits and len(data),
+// followed by data, to w.
+func writeTypeLength(w io.Writer, typeBits byte, data []byte) error {
+	if len(data) > maxFieldLen {
+		return fmt.Errorf("field data too long (%d bytes): max is %d", len(data), maxFieldLen)
+	}
+	tl := typeBits<<6 | byte(len(data))
+	if _, err := w.Write([]byte{tl}); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// marshalText writes the most compact type/length encoding of s to w:
+// BCD Plus if every character is representable, else Packed 6-bit ASCII if
+// every character is representable, else 8-bit ASCII+Latin1 (lang == 0) or
+// UTF-16LE (lang != 0).
+func marshalText(w io.Writer, s string, lang byte) error {
+	b := []byte(s)
+	if isBCDPlus(b) {
+		var enc BCDPlusBytes
+		if _, err := enc.Encode(b); err != nil {
+			return err
+		}
+		return writeTypeLength(w, 1, enc)
+	}
+	if isPacked6BitAscii(b) {
+		var enc Packed6BitAsciiBytes
+		if err := enc.Encode(b); err != nil {
+			return err
+		}
+		return writeTypeLength(w, 2, enc)
+	}
+	if lang == 0 {
+		data, err := encodeLatin1(s)
+		if err != nil {
+			return err
+		}
+		return writeTypeLength(w, 3, data)
+	}
+	return writeTypeLength(w, 3, encodeUTF16LE(s))
+}
+
+// isBCDPlus reports whether every byte of b is a valid BCD Plus character.
+func isBCDPlus(b []byte) bool {
+	for _, c := range b {
+		if strings.IndexByte(bcdPlusCodes, c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isPacked6BitAscii reports whether every byte of b is in the Packed 6-bit
+// ASCII range.
+func isPacked6BitAscii(b []byte) bool {
+	for _, c := range b {
+		if c < firstPacked6BitAscii || c > lastPacked6BitAscii {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeLatin1 decodes Latin-1 (ISO 8859-1) bytes, where each byte maps
+// directly to the Unicode code point of the same value, into a string.
+func decodeLatin1(data []byte) string {
+	r := make([]rune, len(data))
+	for i, b := range data {
+		r[i] = rune(b)
+	}
+	return string(r)
+}
+
+// encodeLatin1 encodes s as Latin-1 bytes. It returns an error if s
+// contains a rune above U+00FF, which has no Latin-1 representation.
+func encodeLatin1(s string) ([]byte, error) {
+	rs := []rune(s)
+	data := make([]byte, len(rs))
+	for i, r := range rs {
+		if r > 0xff {
+			return nil, fmt.Errorf("char %q at rune index %d is not representable in Latin-1", r, i)
+		}
+		data[i] = byte(r)
+	}
+	return data, nil
+}
+
+// decodeUTF16LE decodes little-endian UTF-16 bytes into a string.
+func decodeUTF16LE(data []byte) (string, error) {
+	if len(data)%2 != 0 {
+		return "", fmt.Errorf("invalid UTF-16LE data length (%d): must be even", len(data))
+	}
+	u16 := make([]uint16, len(data)/2)
+	for i := range u16 {
+		u16[i] = binary.LittleEndian.Uint16(data[2*i:])
+	}
+	return string(utf16.Decode(u16)), nil
+}
+
+// encodeUTF16LE encodes s as little-endian UTF-16 bytes.
+func encodeUTF16LE(s string) []byte {
+	u16 := utf16.Encode([]rune(s))
+	data := make([]byte, len(u16)*2)
+	for i, v := range u16 {
+		binary.LittleEndian.PutUint16(data[2*i:], v)
+	}
+	return data
+}
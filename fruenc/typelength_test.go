@@ -0,0 +1,97 @@
+package fruenc
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTypeLengthRoundTripText(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		lang byte
+	}{
+		{"bcdplus", "1234-56", 0},
+		{"packed6bit", "IPMITOOL", 0},
+		{"latin1", "hello, world!", 0},
+		{"utf16", "hello", 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			f := TypeLengthField{Type: FieldTypeText, Value: c.s}
+			if err := f.Marshal(&buf, c.lang); err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			got, err := ParseTypeLength(&buf, c.lang)
+			if err != nil {
+				t.Fatalf("ParseTypeLength: %v", err)
+			}
+			if got.Value != c.s {
+				t.Errorf("round trip %q -> %q", c.s, got.Value)
+			}
+		})
+	}
+}
+
+func TestTypeLengthBinary(t *testing.T) {
+	var buf bytes.Buffer
+	f := TypeLengthField{Type: FieldTypeBinary, Raw: []byte{0x01, 0x02, 0x03}}
+	if err := f.Marshal(&buf, 0); err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	got, err := ParseTypeLength(&buf, 0)
+	if err != nil {
+		t.Fatalf("ParseTypeLength: %v", err)
+	}
+	if got.Type != FieldTypeBinary || !bytes.Equal(got.Raw, f.Raw) {
+		t.Errorf("round trip %+v -> %+v", f, got)
+	}
+}
+
+func TestTypeLengthSentinels(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (TypeLengthField{Empty: true}).Marshal(&buf, 0); err != nil {
+		t.Fatalf("Marshal empty: %v", err)
+	}
+	got, err := ParseTypeLength(&buf, 0)
+	if err != nil {
+		t.Fatalf("ParseTypeLength empty: %v", err)
+	}
+	if !got.Empty {
+		t.Errorf("expected Empty field, got %+v", got)
+	}
+
+	buf.Reset()
+	if err := (TypeLengthField{EndOfFields: true}).Marshal(&buf, 0); err != nil {
+		t.Fatalf("Marshal end-of-fields: %v", err)
+	}
+	got, err = ParseTypeLength(&buf, 0)
+	if err != nil {
+		t.Fatalf("ParseTypeLength end-of-fields: %v", err)
+	}
+	if !got.EndOfFields {
+		t.Errorf("expected EndOfFields field, got %+v", got)
+	}
+}
+
+func TestMarshalTextPicksMostCompactEncoding(t *testing.T) {
+	var buf bytes.Buffer
+	if err := marshalText(&buf, "123", 0); err != nil {
+		t.Fatalf("marshalText: %v", err)
+	}
+	got, err := ParseTypeLength(&buf, 0)
+	if err != nil {
+		t.Fatalf("ParseTypeLength: %v", err)
+	}
+	if got.Type != FieldTypeBCDPlus {
+		t.Errorf("marshalText(%q) encoded as %v, want FieldTypeBCDPlus", "123", got.Type)
+	}
+}
+
+func TestParseTypeLengthTruncated(t *testing.T) {
+	// type/length byte claims 5 bytes of data but none follow.
+	if _, err := ParseTypeLength(bytes.NewReader([]byte{0x05}), 0); err == nil {
+		t.Fatal("expected error for truncated field data, got nil")
+	}
+}
@@ -0,0 +1,46 @@
+package fruenc
+
+import "testing"
+
+func TestZeroChecksumHashInterface(t *testing.T) {
+	z := NewZeroChecksum()
+	if got := z.Size(); got != 1 {
+		t.Errorf("Size() = %d, want 1", got)
+	}
+	if got := z.BlockSize(); got != 1 {
+		t.Errorf("BlockSize() = %d, want 1", got)
+	}
+
+	z.Write([]byte{0x01, 0x02, 0x03})
+	sum := z.Sum(nil)[0]
+	var total byte
+	for _, b := range []byte{0x01, 0x02, 0x03, sum} {
+		total += b
+	}
+	if total != 0 {
+		t.Errorf("range including checksum byte sums to %d, want 0", total)
+	}
+}
+
+func TestZeroChecksumWriteInMultipleCalls(t *testing.T) {
+	a := NewZeroChecksum()
+	a.Write([]byte{0x01, 0x02, 0x03})
+
+	b := NewZeroChecksum()
+	b.Write([]byte{0x01})
+	b.Write([]byte{0x02})
+	b.Write([]byte{0x03})
+
+	if a.Sum(nil)[0] != b.Sum(nil)[0] {
+		t.Errorf("checksum depends on Write call boundaries: %02x != %02x", a.Sum(nil)[0], b.Sum(nil)[0])
+	}
+}
+
+func TestZeroChecksumReset(t *testing.T) {
+	z := NewZeroChecksum()
+	z.Write([]byte{0x01, 0x02, 0x03})
+	z.Reset()
+	if got := z.Sum(nil)[0]; got != 0 {
+		t.Errorf("Sum after Reset = 0x%02x, want 0x00", got)
+	}
+}